@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache 2.0
+
+package cmd
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"time"
+)
+
+const (
+	backoffBase = time.Second
+	backoffCap  = 60 * time.Second
+)
+
+// backoffWithFullJitter returns the sleep duration for a zero-based retry
+// attempt using exponential backoff with full jitter:
+// sleep = random(0, min(cap, base*2^attempt)).
+func backoffWithFullJitter(attempt int) time.Duration {
+	ceiling := backoffBase << attempt
+	if attempt >= 6 || ceiling <= 0 || ceiling > backoffCap {
+		ceiling = backoffCap
+	}
+
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(ceiling)))
+	if err != nil {
+		return ceiling / 2
+	}
+	return time.Duration(n.Int64())
+}
+
+// jitterDelay applies the spec-allowed ±25% jitter to d.
+func jitterDelay(d time.Duration) time.Duration {
+	if d <= 0 {
+		return d
+	}
+
+	quarter := d / 4
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(quarter)*2+1))
+	if err != nil {
+		return d
+	}
+	return d - quarter + time.Duration(n.Int64())
+}
+
+// sleepContext sleeps for d, returning early with ctx.Err() if ctx is
+// canceled first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache 2.0
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var (
+	containerRuntime string
+	containerStorage string
+)
+
+var validContainerRuntimes = []string{"podman", "docker", "containerd"}
+
+func init() {
+	onboardCmd.Flags().StringVar(&containerRuntime, "container-runtime", "podman", "Container runtime used by the fdo.container FSIM to pull/run images [options: podman, docker, containerd]")
+	onboardCmd.Flags().StringVar(&containerStorage, "container-storage", "", "A containers-storage path to pull OCI images into (fdo.container FSIM disabled if empty)")
+}
+
+func validateContainerFlags() error {
+	if containerStorage != "" && !slices.Contains(validContainerRuntimes, containerRuntime) {
+		return fmt.Errorf("invalid container runtime: %s", containerRuntime)
+	}
+	return nil
+}
+
+// containerFSIM implements the fdo.container service info module. The
+// owner sends an OCI image reference and optional run arguments; the
+// module pulls the image into containerStorage via containerRuntime,
+// optionally starts it, and streams pull progress back as service info
+// messages. A pull/run error is returned from Yield, failing TO2.
+type containerFSIM struct {
+	mu       sync.Mutex
+	imageRef string
+	runArgs  []string
+	progress []string
+	done     bool
+	pullErr  error
+}
+
+func (m *containerFSIM) Transition(active bool) error {
+	if !active {
+		return nil
+	}
+
+	m.mu.Lock()
+	imageRef := m.imageRef
+	runArgs := m.runArgs
+	m.mu.Unlock()
+
+	if imageRef == "" {
+		return fmt.Errorf("fdo.container: no image reference received from owner")
+	}
+
+	go m.pullAndRun(imageRef, runArgs)
+	return nil
+}
+
+func (m *containerFSIM) Receive(ctx context.Context, messageName string, messageBody io.Reader) error {
+	body, err := io.ReadAll(messageBody)
+	if err != nil {
+		return fmt.Errorf("fdo.container: read %s: %w", messageName, err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	switch messageName {
+	case "image_ref":
+		m.imageRef = strings.TrimSpace(string(body))
+	case "run_args":
+		if len(body) > 0 {
+			m.runArgs = strings.Fields(string(body))
+		}
+	default:
+		return fmt.Errorf("fdo.container: unexpected message %s", messageName)
+	}
+	return nil
+}
+
+func (m *containerFSIM) Yield(ctx context.Context) (messageName string, messageBody []byte, ok bool, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.progress) > 0 {
+		line := m.progress[0]
+		m.progress = m.progress[1:]
+		return "progress", []byte(line), true, nil
+	}
+
+	if m.done {
+		if m.pullErr != nil {
+			return "", nil, false, fmt.Errorf("fdo.container: %w", m.pullErr)
+		}
+		return "done", nil, true, nil
+	}
+
+	return "", nil, false, nil
+}
+
+// pullAndRun shells out to containerRuntime to pull imageRef into
+// containerStorage, running it with runArgs if given, recording stdout
+// lines as progress and the terminal error (if any) for Yield to return.
+func (m *containerFSIM) pullAndRun(imageRef string, runArgs []string) {
+	if err := m.pull(imageRef); err != nil {
+		m.finish(err)
+		return
+	}
+
+	if len(runArgs) > 0 {
+		if err := m.run(imageRef, runArgs); err != nil {
+			m.finish(err)
+			return
+		}
+	}
+
+	m.finish(nil)
+}
+
+func (m *containerFSIM) pull(imageRef string) error {
+	cmd, err := runtimeCommand("pull", imageRef, nil)
+	if err != nil {
+		return err
+	}
+	return m.runStreamed(cmd)
+}
+
+func (m *containerFSIM) run(imageRef string, runArgs []string) error {
+	cmd, err := runtimeCommand("run", imageRef, runArgs)
+	if err != nil {
+		return err
+	}
+	return m.runStreamed(cmd)
+}
+
+// runtimeCommand builds the containerRuntime invocation for pulling or
+// running imageRef. podman, docker, and containerd each expose their
+// storage root and pull/run verbs differently, so the command has to be
+// built per runtime rather than as a single shared flag/verb shape.
+func runtimeCommand(action, imageRef string, runArgs []string) (*exec.Cmd, error) {
+	switch containerRuntime {
+	case "podman":
+		args := []string{"--root", containerStorage, action}
+		args = append(args, runArgs...)
+		args = append(args, imageRef)
+		return exec.Command("podman", args...), nil
+
+	case "docker":
+		// docker has no per-invocation storage root equivalent to podman's
+		// --root: image/container storage location is a dockerd daemon
+		// startup flag (--data-root), not something a client command can
+		// override, so --container-storage has no effect for this runtime.
+		args := []string{action}
+		args = append(args, runArgs...)
+		args = append(args, imageRef)
+		return exec.Command("docker", args...), nil
+
+	case "containerd":
+		switch action {
+		case "pull":
+			return exec.Command("ctr", "--root", containerStorage, "images", "pull", imageRef), nil
+		case "run":
+			args := []string{"--root", containerStorage, "run"}
+			args = append(args, runArgs...)
+			args = append(args, imageRef, containerID(imageRef))
+			return exec.Command("ctr", args...), nil
+		}
+	}
+
+	return nil, fmt.Errorf("fdo.container: unsupported container runtime %q", containerRuntime)
+}
+
+// containerID derives a ctr container ID from an image reference, since
+// unlike podman/docker, `ctr run` requires an ID distinct from the image
+// name.
+func containerID(imageRef string) string {
+	var b strings.Builder
+	for _, r := range imageRef {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('-')
+		}
+	}
+	return b.String()
+}
+
+func (m *containerFSIM) runStreamed(cmd *exec.Cmd) error {
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("%s: %w", cmd.String(), err)
+	}
+	cmd.Stderr = cmd.Stdout
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("%s: %w", cmd.String(), err)
+	}
+
+	scanner := bufio.NewScanner(stdout)
+	for scanner.Scan() {
+		m.addProgress(scanner.Text())
+	}
+
+	if err := cmd.Wait(); err != nil {
+		return fmt.Errorf("%s: %w", cmd.String(), err)
+	}
+	return nil
+}
+
+func (m *containerFSIM) addProgress(line string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.progress = append(m.progress, line)
+}
+
+func (m *containerFSIM) finish(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.done = true
+	m.pullErr = err
+}
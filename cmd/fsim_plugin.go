@@ -0,0 +1,232 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache 2.0
+
+package cmd
+
+//go:generate protoc -I .. --go_out=.. --go_opt=paths=source_relative --go-grpc_out=.. --go-grpc_opt=paths=source_relative ../internal/fsimpb/fsim.proto
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"plugin"
+	"strings"
+
+	"github.com/fido-device-onboard/go-fdo-client/internal/fsimpb"
+	"github.com/fido-device-onboard/go-fdo/serviceinfo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// DeviceModuleProvider is implemented by anything that can supply one or
+// more named FSIM device modules to be registered for a TO2 session,
+// analogous to how cobra subcommands register themselves with a root
+// command. Built-in modules (fdo.download, fdo.command, ...) are wired
+// directly into transferOwnership2; providers registered here let
+// third-party FSIMs be added without forking the client.
+type DeviceModuleProvider interface {
+	// Modules returns the FSIM modules this provider supplies, keyed by
+	// their advertised module name (e.g. "com.example.foo").
+	Modules(ctx context.Context) (map[string]serviceinfo.DeviceModule, error)
+
+	// Close releases any resources (connections, processes) held by the
+	// provider's modules.
+	Close() error
+}
+
+// fsimPluginVar implements pflag.Value for the repeatable --fsim-plugin
+// flag, whose value has the form "addr=unix:///...,name=com.example.foo".
+type fsimPluginVar []fsimPluginSpec
+
+type fsimPluginSpec struct {
+	addr string
+	name string
+}
+
+var (
+	fsimPluginFlag    fsimPluginVar
+	fsimGoPluginPaths []string
+)
+
+// fsimGoPluginSymbol is the exported symbol name a --fsim-go-plugin shared
+// object must provide, of type DeviceModuleProvider.
+const fsimGoPluginSymbol = "FSIMProvider"
+
+func init() {
+	onboardCmd.Flags().Var(&fsimPluginFlag, "fsim-plugin",
+		"Dial an out-of-process FSIM gRPC plugin, addr=unix:///path,name=com.example.foo (name optional, discovered from the plugin if omitted); repeatable")
+	onboardCmd.Flags().StringArrayVar(&fsimGoPluginPaths, "fsim-go-plugin", nil,
+		"Path to a Go plugin (.so) exporting a "+fsimGoPluginSymbol+" symbol implementing DeviceModuleProvider; repeatable")
+}
+
+func (v *fsimPluginVar) String() string {
+	if v == nil || len(*v) == 0 {
+		return "[]"
+	}
+	specs := make([]string, len(*v))
+	for i, spec := range *v {
+		specs[i] = fmt.Sprintf("addr=%s,name=%s", spec.addr, spec.name)
+	}
+	return "[" + strings.Join(specs, ",") + "]"
+}
+
+func (v *fsimPluginVar) Set(val string) error {
+	spec := fsimPluginSpec{}
+	for _, kv := range strings.Split(val, ",") {
+		k, v2, ok := strings.Cut(kv, "=")
+		if !ok {
+			return fmt.Errorf("invalid --fsim-plugin entry %q: expected key=value", kv)
+		}
+		switch k {
+		case "addr":
+			spec.addr = v2
+		case "name":
+			spec.name = v2
+		default:
+			return fmt.Errorf("invalid --fsim-plugin key %q: expected addr or name", k)
+		}
+	}
+	if spec.addr == "" {
+		return fmt.Errorf("invalid --fsim-plugin entry %q: addr is required", val)
+	}
+	*v = append(*v, spec)
+	return nil
+}
+
+func (v *fsimPluginVar) Type() string {
+	return "fsimPlugin"
+}
+
+// loadFsimPlugins loads every provider named on the command line, either
+// dialed as an out-of-process gRPC plugin (--fsim-plugin) or loaded as a Go
+// plugin (--fsim-go-plugin), and returns their combined modules, ready to be
+// merged into the fsims map passed to fdo.TO2, plus the providers
+// themselves so their Close can release connections/resources afterwards.
+func loadFsimPlugins(ctx context.Context) (map[string]serviceinfo.DeviceModule, []io.Closer, error) {
+	providers := make([]DeviceModuleProvider, 0, len(fsimPluginFlag)+len(fsimGoPluginPaths))
+	closers := make([]io.Closer, 0, len(fsimPluginFlag)+len(fsimGoPluginPaths))
+
+	for _, spec := range fsimPluginFlag {
+		provider, err := dialGrpcFsimPlugin(ctx, spec)
+		if err != nil {
+			return nil, closers, err
+		}
+		providers = append(providers, provider)
+		closers = append(closers, provider)
+	}
+
+	for _, path := range fsimGoPluginPaths {
+		provider, err := loadGoFsimPlugin(path)
+		if err != nil {
+			return nil, closers, err
+		}
+		providers = append(providers, provider)
+		closers = append(closers, provider)
+	}
+
+	fsims := make(map[string]serviceinfo.DeviceModule, len(providers))
+	for _, provider := range providers {
+		modules, err := provider.Modules(ctx)
+		if err != nil {
+			return nil, closers, fmt.Errorf("load FSIM provider modules: %w", err)
+		}
+		for name, module := range modules {
+			fsims[name] = module
+		}
+	}
+
+	return fsims, closers, nil
+}
+
+// dialGrpcFsimPlugin dials an out-of-process ServiceInfoModule gRPC plugin
+// and returns a DeviceModuleProvider that supplies its single advertised
+// module.
+func dialGrpcFsimPlugin(ctx context.Context, spec fsimPluginSpec) (DeviceModuleProvider, error) {
+	conn, err := grpc.NewClient(spec.addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("dial FSIM plugin %s: %w", spec.addr, err)
+	}
+
+	client := fsimpb.NewServiceInfoModuleClient(conn)
+	name := spec.name
+	if name == "" {
+		reply, err := client.Name(ctx, &fsimpb.NameRequest{})
+		if err != nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("discover FSIM plugin name at %s: %w", spec.addr, err)
+		}
+		name = reply.GetName()
+	}
+
+	return &grpcPluginProvider{conn: conn, module: &grpcDeviceModule{name: name, client: client}}, nil
+}
+
+// loadGoFsimPlugin opens a Go plugin (.so) and looks up its exported
+// DeviceModuleProvider symbol.
+func loadGoFsimPlugin(path string) (DeviceModuleProvider, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open Go plugin %s: %w", path, err)
+	}
+
+	sym, err := p.Lookup(fsimGoPluginSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("Go plugin %s: lookup %s: %w", path, fsimGoPluginSymbol, err)
+	}
+
+	provider, ok := sym.(DeviceModuleProvider)
+	if !ok {
+		return nil, fmt.Errorf("Go plugin %s: %s does not implement DeviceModuleProvider", path, fsimGoPluginSymbol)
+	}
+
+	return provider, nil
+}
+
+// grpcPluginProvider implements DeviceModuleProvider for a single dialed
+// FSIM gRPC plugin connection.
+type grpcPluginProvider struct {
+	conn   *grpc.ClientConn
+	module *grpcDeviceModule
+}
+
+func (p *grpcPluginProvider) Modules(ctx context.Context) (map[string]serviceinfo.DeviceModule, error) {
+	return map[string]serviceinfo.DeviceModule{p.module.name: p.module}, nil
+}
+
+func (p *grpcPluginProvider) Close() error {
+	return p.conn.Close()
+}
+
+// grpcDeviceModule adapts an out-of-process ServiceInfoModule gRPC plugin
+// to the serviceinfo.DeviceModule interface used by fdo.TO2.
+type grpcDeviceModule struct {
+	name   string
+	client fsimpb.ServiceInfoModuleClient
+}
+
+func (m *grpcDeviceModule) Transition(active bool) error {
+	_, err := m.client.Transition(context.Background(), &fsimpb.TransitionRequest{Active: active})
+	if err != nil {
+		return fmt.Errorf("FSIM plugin %s: transition: %w", m.name, err)
+	}
+	return nil
+}
+
+func (m *grpcDeviceModule) Receive(ctx context.Context, messageName string, messageBody io.Reader) error {
+	body, err := io.ReadAll(messageBody)
+	if err != nil {
+		return fmt.Errorf("FSIM plugin %s: read message %s: %w", m.name, messageName, err)
+	}
+	if _, err := m.client.Receive(ctx, &fsimpb.ReceiveRequest{MessageName: messageName, MessageBody: body}); err != nil {
+		return fmt.Errorf("FSIM plugin %s: receive %s: %w", m.name, messageName, err)
+	}
+	return nil
+}
+
+func (m *grpcDeviceModule) Yield(ctx context.Context) (messageName string, messageBody []byte, ok bool, err error) {
+	reply, err := m.client.Yield(ctx, &fsimpb.YieldRequest{})
+	if err != nil {
+		return "", nil, false, fmt.Errorf("FSIM plugin %s: yield: %w", m.name, err)
+	}
+	return reply.GetMessageName(), reply.GetMessageBody(), reply.GetHasMessage(), nil
+}
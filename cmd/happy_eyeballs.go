@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache 2.0
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+)
+
+// happyEyeballsStagger is the delay between starting successive probe
+// attempts, as recommended for Happy-Eyeballs-style connection racing.
+const happyEyeballsStagger = 250 * time.Millisecond
+
+// happyEyeballsDNSTimeout bounds each IPv6-preference DNS lookup, so that a
+// slow or unresponsive resolver can delay the start of the probe race by at
+// most this long rather than stalling it indefinitely.
+const happyEyeballsDNSTimeout = 300 * time.Millisecond
+
+var probeParallelism int
+
+func init() {
+	onboardCmd.Flags().IntVar(&probeParallelism, "probe-parallelism", 2, "Max number of RV/owner URLs to probe concurrently")
+}
+
+// happyEyeballsProbe runs attempt against each of urls concurrently,
+// staggering the start of each by happyEyeballsStagger and bounding
+// concurrency to probeParallelism. URLs whose host resolves an IPv6
+// address are tried before IPv4-only ones. The first attempt to succeed
+// wins; its result and URL are returned and the remaining in-flight
+// attempts are canceled via context.
+func happyEyeballsProbe[T any](ctx context.Context, urls []string, attempt func(ctx context.Context, url string) (T, error)) (result T, wonURL string, err error) {
+	if len(urls) == 0 {
+		return result, "", fmt.Errorf("no candidate URLs")
+	}
+
+	ordered := preferIPv6(ctx, urls)
+
+	probeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type outcome struct {
+		url   string
+		value T
+		err   error
+	}
+	outcomes := make(chan outcome, len(ordered))
+	sem := make(chan struct{}, max(1, probeParallelism))
+
+	var wg sync.WaitGroup
+	for i, probeURL := range ordered {
+		wg.Add(1)
+		go func(i int, probeURL string) {
+			defer wg.Done()
+
+			select {
+			case <-probeCtx.Done():
+				return
+			case <-time.After(time.Duration(i) * happyEyeballsStagger):
+			}
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-probeCtx.Done():
+				return
+			}
+
+			value, err := attempt(probeCtx, probeURL)
+			select {
+			case outcomes <- outcome{url: probeURL, value: value, err: err}:
+			case <-probeCtx.Done():
+			}
+		}(i, probeURL)
+	}
+
+	go func() {
+		wg.Wait()
+		close(outcomes)
+	}()
+
+	for o := range outcomes {
+		if o.err == nil {
+			cancel()
+			return o.value, o.url, nil
+		}
+		err = o.err
+	}
+	if err == nil {
+		err = fmt.Errorf("all probe attempts failed")
+	}
+	return result, "", err
+}
+
+// preferIPv6 reorders urls so that hosts with an AAAA record sort before
+// hosts that only resolve an A record, per net.DefaultResolver.LookupIPAddr.
+// Lookups run concurrently and honor ctx, so a slow or canceled resolver
+// delays the probe race by at most happyEyeballsDNSTimeout rather than
+// serializing one lookup per URL ahead of it.
+func preferIPv6(ctx context.Context, urls []string) []string {
+	hasV6 := make([]bool, len(urls))
+	var wg sync.WaitGroup
+	for i, rawURL := range urls {
+		wg.Add(1)
+		go func(i int, rawURL string) {
+			defer wg.Done()
+			hasV6[i] = hasIPv6Address(ctx, rawURL)
+		}(i, rawURL)
+	}
+	wg.Wait()
+
+	type candidate struct {
+		url   string
+		hasV6 bool
+	}
+	ordered := make([]candidate, len(urls))
+	for i, rawURL := range urls {
+		ordered[i] = candidate{url: rawURL, hasV6: hasV6[i]}
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].hasV6 && !ordered[j].hasV6
+	})
+
+	result := make([]string, len(ordered))
+	for i, c := range ordered {
+		result[i] = c.url
+	}
+	return result
+}
+
+func hasIPv6Address(ctx context.Context, rawURL string) bool {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+
+	lookupCtx, cancel := context.WithTimeout(ctx, happyEyeballsDNSTimeout)
+	defer cancel()
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(lookupCtx, parsed.Hostname())
+	if err != nil {
+		return false
+	}
+	for _, addr := range addrs {
+		if addr.IP.To4() == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -26,19 +26,22 @@ import (
 	"github.com/fido-device-onboard/go-fdo/protocol"
 	"github.com/fido-device-onboard/go-fdo/serviceinfo"
 	"github.com/spf13/cobra"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type fsVar map[string]string
 
 var (
-	cipherSuite string
-	dlDir       string
-	echoCmds    bool
-	kexSuite    string
-	rvOnly      bool
-	resale      bool
-	uploads     = make(fsVar)
-	wgetDir     string
+	cipherSuite      string
+	dlDir            string
+	echoCmds         bool
+	kexSuite         string
+	rvOnly           bool
+	resale           bool
+	to1MaxAttempts   int
+	allowRootUploads bool
+	uploads          = make(fsVar)
+	wgetDir          string
 )
 var validCipherSuites = []string{
 	"A128GCM", "A192GCM", "A256GCM",
@@ -60,6 +63,17 @@ var onboardCmd = &cobra.Command{
 		if debug {
 			level.Set(slog.LevelDebug)
 		}
+		initLogging()
+
+		shutdownTracing, err := initTracing(clientContext)
+		if err != nil {
+			return fmt.Errorf("tracing setup failed: %w", err)
+		}
+		defer func() {
+			if err := shutdownTracing(context.Background()); err != nil {
+				slog.Error("tracing shutdown failed", "error", err)
+			}
+		}()
 
 		if tpmPath != "" {
 			var err error
@@ -101,6 +115,8 @@ func init() {
 	onboardCmd.Flags().BoolVar(&insecureTLS, "insecure-tls", false, "Skip TLS certificate verification")
 	onboardCmd.Flags().BoolVar(&rvOnly, "rv-only", false, "Perform TO1 then stop")
 	onboardCmd.Flags().BoolVar(&resale, "resale", false, "Perform resale")
+	onboardCmd.Flags().IntVar(&to1MaxAttempts, "to1-max-attempts", 3, "Max attempts per RV/owner URL, with exponential backoff between attempts")
+	onboardCmd.Flags().BoolVar(&allowRootUploads, "allow-root-uploads", false, "Allow '--upload /' to grant the owner read access to the whole filesystem (refused by default)")
 	onboardCmd.Flags().Var(&uploads, "upload", "List of dirs and files to upload files from, comma-separated and/or flag provided multiple times (FSIM disabled if empty)")
 	onboardCmd.Flags().StringVar(&wgetDir, "wget-dir", "", "A dir to wget files into (FSIM disabled if empty)")
 
@@ -118,6 +134,25 @@ func doOnboard() error {
 		return err
 	}
 
+	// When configured, source the device identity from the SPIFFE Workload
+	// API instead of the file/TPM-backed credential key.
+	if spiffeSocket != "" {
+		spiffeCred, err := fetchSpiffeCredential(clientContext)
+		if err != nil {
+			return fmt.Errorf("fetch SPIFFE credential: %w", err)
+		}
+		privateKey = spiffeCred
+
+		// Open one X.509 source for the whole run, reused by deviceTransport
+		// on every TO1/TO2 dial attempt, instead of opening a fresh Workload
+		// API stream per attempt.
+		source, err := openSpiffeX509Source(clientContext)
+		if err != nil {
+			return fmt.Errorf("open SPIFFE X.509 source: %w", err)
+		}
+		defer func() { _ = source.Close() }()
+	}
+
 	// Try TO1+TO2
 	kexCipherSuiteID, ok := kex.CipherSuiteByName(cipherSuite)
 	if !ok {
@@ -165,30 +200,36 @@ func transferOwnership(ctx context.Context, rvInfo [][]protocol.RvInstruction, c
 		}
 	}
 
-	// Try TO1 on each address only once
+	// Try TO1 against each directive in turn. Within a directive, all
+	// candidate URLs are probed concurrently Happy-Eyeballs style, each
+	// with its own exponential backoff retry; the first successful URL
+	// wins and the rest are canceled.
 	var to1d *cose.Sign1[protocol.To1d, []byte]
-TO1:
-	for _, directive := range directives {
+	for directiveIdx, directive := range directives {
 		if directive.Bypass {
 			continue
 		}
 
-		for _, url := range directive.URLs {
-			var err error
-			to1d, err = fdo.TO1(context.TODO(), tls.TlsTransport(url.String(), nil, insecureTLS), conf.Cred, conf.Key, nil)
-			if err != nil {
-				slog.Error("TO1 failed", "base URL", url.String(), "error", err)
-				continue
+		if len(directive.URLs) > 0 {
+			urls := make([]string, len(directive.URLs))
+			for i, url := range directive.URLs {
+				urls[i] = url.String()
+			}
+
+			result, wonURL, err := happyEyeballsProbe(ctx, urls, func(attemptCtx context.Context, url string) (*cose.Sign1[protocol.To1d, []byte], error) {
+				return to1Attempt(attemptCtx, url, directiveIdx, conf)
+			})
+			if err == nil {
+				to1d = result
+				slog.Debug("TO1 succeeded", "url", wonURL, "directive_idx", directiveIdx)
+				break
 			}
-			break TO1
+			slog.Error("TO1 failed for all URLs in directive", "directive_idx", directiveIdx, "error", err)
 		}
 
 		if directive.Delay != 0 {
-			// A 25% plus or minus jitter is allowed by spec
-			select {
-			case <-ctx.Done():
+			if err := sleepContext(ctx, jitterDelay(directive.Delay)); err != nil {
 				return nil
-			case <-time.After(directive.Delay):
 			}
 		}
 	}
@@ -234,18 +275,131 @@ TO1:
 		return nil
 	}
 
-	// Try TO2 on each address only once
-	for _, baseURL := range to2URLs {
-		newDC := transferOwnership2(tls.TlsTransport(baseURL, nil, insecureTLS), to1d, conf)
-		if newDC != nil {
-			return newDC
+	// Probe all TO2 addresses concurrently, Happy-Eyeballs style, each with
+	// its own exponential backoff retry; the first successful URL wins.
+	newDC, wonURL, err := happyEyeballsProbe(ctx, to2URLs, func(attemptCtx context.Context, baseURL string) (*fdo.DeviceCredential, error) {
+		return to2Attempt(attemptCtx, baseURL, to1d, conf)
+	})
+	if err != nil {
+		slog.Error("TO2 failed for all URLs", "error", err)
+		return nil
+	}
+	slog.Debug("TO2 succeeded", "url", wonURL)
+	return newDC
+}
+
+// to1Attempt dials url and runs TO1 against it, making up to
+// to1MaxAttempts attempts with exponential backoff and full jitter between
+// them, wrapping each attempt in its own span.
+func to1Attempt(ctx context.Context, url string, directiveIdx int, conf fdo.TO2Config) (*cose.Sign1[protocol.To1d, []byte], error) {
+	var lastErr error
+	for attempt := 0; attempt < to1MaxAttempts; attempt++ {
+		spanCtx, span := tracer.Start(ctx, "TO1")
+		span.SetAttributes(
+			attribute.String("fdo.guid", conf.Cred.GUID.String()),
+			attribute.String("fdo.rv_url", url),
+			attribute.String("fdo.kex_suite", kexSuite),
+		)
+
+		// Dial with spanCtx, not ctx: initTracing wraps the default HTTP
+		// transport with otelhttp, which reads the active span from each
+		// outgoing request's context and injects its trace headers, letting
+		// owner-side tracing stitch to this attempt's span rather than its
+		// parent.
+		transport, err := deviceTransport(spanCtx, url)
+		var to1d *cose.Sign1[protocol.To1d, []byte]
+		if err == nil {
+			to1d, err = fdo.TO1(spanCtx, transport, conf.Cred, conf.Key, nil)
+		}
+		if err != nil {
+			span.RecordError(err)
+		}
+		span.End()
+		if err == nil {
+			return to1d, nil
+		}
+		lastErr = err
+
+		if attempt == to1MaxAttempts-1 {
+			slog.Error("TO1 failed, giving up on URL", "url", url, "directive_idx", directiveIdx, "attempt", attempt, "error", err)
+			break
+		}
+
+		backoff := backoffWithFullJitter(attempt)
+		slog.Error("TO1 attempt failed, retrying", "url", url, "directive_idx", directiveIdx, "attempt", attempt, "backoff_ms", backoff.Milliseconds(), "error", err)
+		if err := sleepContext(ctx, backoff); err != nil {
+			return nil, err
 		}
 	}
+	return nil, lastErr
+}
 
-	return nil
+// to2Attempt dials baseURL and runs TO2 against it, making up to
+// to1MaxAttempts attempts with exponential backoff and full jitter between
+// them, wrapping each attempt in its own span.
+func to2Attempt(ctx context.Context, baseURL string, to1d *cose.Sign1[protocol.To1d, []byte], conf fdo.TO2Config) (*fdo.DeviceCredential, error) {
+	var lastErr error
+	for attempt := 0; attempt < to1MaxAttempts; attempt++ {
+		spanCtx, span := tracer.Start(ctx, "TO2")
+		span.SetAttributes(
+			attribute.String("fdo.guid", conf.Cred.GUID.String()),
+			attribute.String("fdo.rv_url", baseURL),
+			attribute.String("fdo.kex_suite", kexSuite),
+			attribute.String("fdo.cipher_suite", cipherSuite),
+		)
+
+		// As in to1Attempt, dial with spanCtx so the transport's outgoing
+		// request carries this attempt's trace headers.
+		transport, err := deviceTransport(spanCtx, baseURL)
+		var newDC *fdo.DeviceCredential
+		if err == nil {
+			newDC = transferOwnership2(spanCtx, transport, to1d, conf)
+			if newDC == nil {
+				err = fmt.Errorf("TO2 did not return a new credential")
+			}
+		}
+		if err != nil {
+			span.RecordError(err)
+		}
+		span.End()
+		if err == nil {
+			return newDC, nil
+		}
+		lastErr = err
+
+		if attempt == to1MaxAttempts-1 {
+			slog.Error("TO2 failed, giving up on URL", "url", baseURL, "attempt", attempt, "error", err)
+			break
+		}
+
+		backoff := backoffWithFullJitter(attempt)
+		slog.Error("TO2 attempt failed, retrying", "url", baseURL, "attempt", attempt, "backoff_ms", backoff.Milliseconds(), "error", err)
+		if err := sleepContext(ctx, backoff); err != nil {
+			return nil, err
+		}
+	}
+	return nil, lastErr
 }
 
-func transferOwnership2(transport fdo.Transport, to1d *cose.Sign1[protocol.To1d, []byte], conf fdo.TO2Config) *fdo.DeviceCredential {
+// deviceTransport builds the fdo.Transport used to dial an RV/owner server.
+// When --spiffe-socket is configured, TLS dialing trusts the SPIFFE trust
+// bundle for --spiffe-trust-domain instead of the system roots (from the
+// X.509 source doOnboard opened once for the run), and the server's
+// presented SPIFFE ID is validated against that trust domain.
+func deviceTransport(ctx context.Context, baseURL string) (fdo.Transport, error) {
+	if spiffeSocket == "" {
+		return tls.TlsTransport(baseURL, nil, insecureTLS), nil
+	}
+
+	tlsConfig, err := spiffeTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return tls.TlsTransportWithConfig(baseURL, tlsConfig), nil
+}
+
+func transferOwnership2(ctx context.Context, transport fdo.Transport, to1d *cose.Sign1[protocol.To1d, []byte], conf fdo.TO2Config) *fdo.DeviceCredential {
 	fsims := map[string]serviceinfo.DeviceModule{
 		"fido_alliance": &fsim.Interop{},
 	}
@@ -284,6 +438,9 @@ func transferOwnership2(transport fdo.Transport, to1d *cose.Sign1[protocol.To1d,
 			FS: uploads,
 		}
 	}
+	if containerStorage != "" {
+		fsims["fdo.container"] = &containerFSIM{}
+	}
 	if wgetDir != "" {
 		fsims["fdo.wget"] = &fsim.Wget{
 			CreateTemp: func() (*os.File, error) {
@@ -303,9 +460,22 @@ func transferOwnership2(transport fdo.Transport, to1d *cose.Sign1[protocol.To1d,
 			Timeout: 10 * time.Second,
 		}
 	}
+	pluginModules, pluginClosers, err := loadFsimPlugins(ctx)
+	defer func() {
+		for _, closer := range pluginClosers {
+			_ = closer.Close()
+		}
+	}()
+	if err != nil {
+		slog.Error("FSIM plugin load failed", "error", err)
+		return nil
+	}
+	for name, module := range pluginModules {
+		fsims[name] = module
+	}
 	conf.DeviceModules = fsims
 
-	cred, err := fdo.TO2(context.TODO(), transport, to1d, conf)
+	cred, err := fdo.TO2(ctx, transport, to1d, conf)
 	if err != nil {
 		slog.Error("TO2 failed", "error", err)
 		return nil
@@ -365,9 +535,14 @@ func (files fsVar) Type() string {
 	return "fsVar"
 }
 
-// Open implements fs.FS
+// Open implements fs.FS. Access is sandboxed to the configured dirs/files:
+// requested paths are resolved against the root they were matched under,
+// with symlinks resolved and checked to stay inside that root, and ".."
+// traversal rejected even after Clean. Granting the owner access to the
+// whole filesystem (an "upload /" entry) requires the explicit
+// --allow-root-uploads opt-in; it is refused by default.
 func (files fsVar) Open(path string) (fs.File, error) {
-	if !fs.ValidPath(path) {
+	if !fs.ValidPath(path) || strings.ContainsRune(path, '\\') {
 		return nil, &fs.PathError{
 			Op:   "open",
 			Path: path,
@@ -375,18 +550,40 @@ func (files fsVar) Open(path string) (fs.File, error) {
 		}
 	}
 
-	// TODO: Enforce chroot-like security
 	if _, rootAccess := files["/"]; rootAccess {
-		return os.Open(filepath.Clean(path))
+		if !allowRootUploads {
+			return nil, &fs.PathError{
+				Op:   "open",
+				Path: path,
+				Err:  fs.ErrPermission,
+			}
+		}
+		resolved, err := resolveSandboxed("/", path)
+		if err != nil {
+			return nil, &fs.PathError{Op: "open", Path: path, Err: err}
+		}
+		return os.Open(resolved)
 	}
 
 	name := pathToName(path, "")
 	if abs, ok := files[name]; ok {
-		return os.Open(filepath.Clean(abs))
+		resolved, err := resolveSandboxed(filepath.Dir(abs), filepath.Base(abs))
+		if err != nil {
+			return nil, &fs.PathError{Op: "open", Path: path, Err: err}
+		}
+		return os.Open(resolved)
 	}
 	for dir := filepath.Dir(name); dir != "/" && dir != "."; dir = filepath.Dir(dir) {
 		if abs, ok := files[dir]; ok {
-			return os.Open(filepath.Clean(abs))
+			rel, err := filepath.Rel(dir, name)
+			if err != nil {
+				return nil, &fs.PathError{Op: "open", Path: path, Err: fs.ErrInvalid}
+			}
+			resolved, err := resolveSandboxed(abs, rel)
+			if err != nil {
+				return nil, &fs.PathError{Op: "open", Path: path, Err: err}
+			}
+			return os.Open(resolved)
 		}
 	}
 	return nil, &fs.PathError{
@@ -396,6 +593,48 @@ func (files fsVar) Open(path string) (fs.File, error) {
 	}
 }
 
+// resolveSandboxed resolves rel against root and confirms the result stays
+// inside root, even once symlinks are followed. root itself may be a
+// symlink (e.g. an uploaded file's parent directory).
+func resolveSandboxed(root, rel string) (string, error) {
+	if strings.ContainsRune(rel, '\\') {
+		return "", fs.ErrInvalid
+	}
+
+	rel = filepath.Clean(rel)
+	if rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fs.ErrInvalid
+	}
+
+	resolvedRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return "", err
+	}
+
+	target := filepath.Join(resolvedRoot, rel)
+	resolvedTarget, err := filepath.EvalSymlinks(target)
+	if err != nil {
+		// Target may not exist yet (or be a broken symlink); os.Open will
+		// fail naturally, but still confirm it doesn't escape via ".."
+		// cleanup alone.
+		resolvedTarget = target
+	}
+
+	// resolvedRoot may already end in a separator (the filesystem root "/"),
+	// in which case appending another one would make every real path fail
+	// the prefix check below.
+	rootPrefix := resolvedRoot
+	if !strings.HasSuffix(rootPrefix, string(filepath.Separator)) {
+		rootPrefix += string(filepath.Separator)
+	}
+
+	if resolvedTarget != resolvedRoot && !strings.HasPrefix(resolvedTarget, rootPrefix) {
+		return "", fs.ErrPermission
+	}
+
+	return target, nil
+}
+
 // The name of the directory or file is its cleaned path, if absolute. If the
 // path given is relative, then remove all ".." and "." at the start. If the
 // path given is only 1 or more ".." or ".", then use the name of the absolute
@@ -447,6 +686,18 @@ func validateOnboardFlags() error {
 		return fmt.Errorf("invalid wget directory: %s", wgetDir)
 	}
 
+	if to1MaxAttempts < 1 {
+		return fmt.Errorf("--to1-max-attempts must be at least 1, got %d", to1MaxAttempts)
+	}
+
+	if spiffeSocket != "" && spiffeTrustDomain == "" {
+		return fmt.Errorf("--spiffe-trust-domain is required when --spiffe-socket is set")
+	}
+
+	if err := validateContainerFlags(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
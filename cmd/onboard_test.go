@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache 2.0
+
+package cmd
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestResolveSandboxedRejectsDotDotTraversal(t *testing.T) {
+	root := t.TempDir()
+	for _, rel := range []string{"..", "../etc/passwd", "a/../../etc/passwd"} {
+		if _, err := resolveSandboxed(root, rel); err == nil {
+			t.Errorf("resolveSandboxed(%q, %q): expected error, got nil", root, rel)
+		}
+	}
+}
+
+func TestResolveSandboxedRejectsWindowsSeparators(t *testing.T) {
+	root := t.TempDir()
+	if _, err := resolveSandboxed(root, `sub\..\..\secret`); err == nil {
+		t.Error("resolveSandboxed: expected a path containing a Windows separator to be rejected")
+	}
+}
+
+func TestResolveSandboxedRejectsSymlinkEscape(t *testing.T) {
+	root := t.TempDir()
+	outside := t.TempDir()
+
+	secret := filepath.Join(outside, "secret.txt")
+	if err := os.WriteFile(secret, []byte("top secret"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	link := filepath.Join(root, "escape")
+	if err := os.Symlink(secret, link); err != nil {
+		t.Skipf("symlinks unsupported on this platform: %v", err)
+	}
+
+	if _, err := resolveSandboxed(root, "escape"); err == nil {
+		t.Error("resolveSandboxed: expected a symlink escaping root to be rejected")
+	}
+}
+
+func TestResolveSandboxedAllowsContainedPath(t *testing.T) {
+	root := t.TempDir()
+	sub := filepath.Join(root, "sub")
+	if err := os.Mkdir(sub, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	resolved, err := resolveSandboxed(root, "sub")
+	if err != nil {
+		t.Fatalf("resolveSandboxed: unexpected error: %v", err)
+	}
+	if filepath.Clean(resolved) != filepath.Clean(sub) {
+		t.Errorf("resolveSandboxed: got %q, want %q", resolved, sub)
+	}
+}
+
+func TestFsVarOpenRejectsDotDotAndBackslash(t *testing.T) {
+	files := fsVar{"allowed": t.TempDir()}
+
+	for _, path := range []string{"../secret", `allowed\..\secret`} {
+		if _, err := files.Open(path); err == nil {
+			t.Errorf("fsVar.Open(%q): expected error, got nil", path)
+		}
+	}
+}
+
+func TestFsVarOpenSymlinkEscape(t *testing.T) {
+	allowedDir := t.TempDir()
+	outside := t.TempDir()
+
+	secret := filepath.Join(outside, "secret.txt")
+	if err := os.WriteFile(secret, []byte("top secret"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	link := filepath.Join(allowedDir, "escape")
+	if err := os.Symlink(secret, link); err != nil {
+		t.Skipf("symlinks unsupported on this platform: %v", err)
+	}
+
+	files := fsVar{"allowed": allowedDir}
+
+	if _, err := files.Open("allowed/escape"); err == nil {
+		t.Error("fsVar.Open: expected a symlink escaping the upload root to be rejected")
+	}
+}
+
+func TestFsVarOpenRootRequiresOptIn(t *testing.T) {
+	files := fsVar{"/": "/"}
+
+	prev := allowRootUploads
+	allowRootUploads = false
+	defer func() { allowRootUploads = prev }()
+
+	if _, err := files.Open("etc/passwd"); !errors.Is(err, fs.ErrPermission) {
+		t.Errorf("fsVar.Open: got %v, want fs.ErrPermission", err)
+	}
+}
+
+func TestResolveSandboxedAllowsFilesystemRoot(t *testing.T) {
+	dir := t.TempDir()
+	resolvedDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	target := filepath.Join(resolvedDir, "allowed.txt")
+	if err := os.WriteFile(target, []byte("hello"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	rel := strings.TrimPrefix(target, string(filepath.Separator))
+	resolved, err := resolveSandboxed(string(filepath.Separator), rel)
+	if err != nil {
+		t.Fatalf("resolveSandboxed(%q, %q): unexpected error: %v", string(filepath.Separator), rel, err)
+	}
+	if filepath.Clean(resolved) != filepath.Clean(target) {
+		t.Errorf("resolveSandboxed: got %q, want %q", resolved, target)
+	}
+}
+
+func TestFsVarOpenRootOptInSucceeds(t *testing.T) {
+	dir := t.TempDir()
+	resolvedDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	target := filepath.Join(resolvedDir, "allowed.txt")
+	if err := os.WriteFile(target, []byte("hello"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	prev := allowRootUploads
+	allowRootUploads = true
+	defer func() { allowRootUploads = prev }()
+
+	files := fsVar{"/": "/"}
+	rel := strings.TrimPrefix(target, string(filepath.Separator))
+	f, err := files.Open(rel)
+	if err != nil {
+		t.Fatalf("fsVar.Open(%q): unexpected error: %v", rel, err)
+	}
+	defer f.Close()
+
+	body, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatalf("read opened file: %v", err)
+	}
+	if string(body) != "hello" {
+		t.Errorf("fsVar.Open: got content %q, want %q", body, "hello")
+	}
+}
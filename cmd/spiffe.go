@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache 2.0
+
+package cmd
+
+import (
+	"context"
+	"crypto"
+	"crypto/tls"
+	"fmt"
+
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/go-spiffe/v2/spiffetls/tlsconfig"
+	"github.com/spiffe/go-spiffe/v2/workloadapi"
+)
+
+var (
+	spiffeSocket      string
+	spiffeTrustDomain string
+)
+
+func init() {
+	onboardCmd.Flags().StringVar(&spiffeSocket, "spiffe-socket", "", "SPIFFE Workload API socket to fetch a device X.509-SVID from, used as the device credential key (disabled if empty)")
+	onboardCmd.Flags().StringVar(&spiffeTrustDomain, "spiffe-trust-domain", "", "Trust domain that the RV/owner server's SPIFFE ID must belong to (required if --spiffe-socket is set)")
+}
+
+// fetchSpiffeCredential dials the Workload API at spiffeSocket and returns
+// the device's current X.509-SVID private key, for use as the device
+// credential key in place of the file/TPM-backed key.
+func fetchSpiffeCredential(ctx context.Context) (crypto.Signer, error) {
+	client, err := workloadapi.New(ctx, workloadapi.WithAddr(spiffeSocket))
+	if err != nil {
+		return nil, fmt.Errorf("dial SPIFFE Workload API: %w", err)
+	}
+	defer client.Close()
+
+	svid, err := client.FetchX509SVID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch X.509-SVID: %w", err)
+	}
+
+	signer, ok := svid.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("X.509-SVID private key does not implement crypto.Signer")
+	}
+
+	return signer, nil
+}
+
+// spiffeX509Source is opened once per run by openSpiffeX509Source (called
+// from doOnboard) and reused by every TO1/TO2 dial attempt, rather than
+// opening a new Workload API stream per attempt.
+var spiffeX509Source *workloadapi.X509Source
+
+// openSpiffeX509Source dials the Workload API at spiffeSocket and caches the
+// resulting X.509 source in spiffeX509Source for spiffeTLSConfig to use. The
+// caller owns the returned source and must Close it once onboarding is done.
+func openSpiffeX509Source(ctx context.Context) (*workloadapi.X509Source, error) {
+	source, err := workloadapi.NewX509Source(ctx, workloadapi.WithClientOptions(workloadapi.WithAddr(spiffeSocket)))
+	if err != nil {
+		return nil, fmt.Errorf("create X.509 source: %w", err)
+	}
+	spiffeX509Source = source
+	return source, nil
+}
+
+// spiffeTLSConfig builds a *tls.Config that dials the RV/owner server using
+// spiffeX509Source's trust bundle for spiffeTrustDomain instead of the
+// system roots, and rejects any peer whose SPIFFE ID is not a member of
+// that trust domain.
+func spiffeTLSConfig() (*tls.Config, error) {
+	trustDomain, err := spiffeid.TrustDomainFromString(spiffeTrustDomain)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --spiffe-trust-domain: %w", err)
+	}
+
+	return tlsconfig.TLSClientConfig(spiffeX509Source, tlsconfig.AuthorizeMemberOf(trustDomain)), nil
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache 2.0
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+var (
+	logFormat    string
+	otlpEndpoint string
+)
+
+func init() {
+	onboardCmd.Flags().StringVar(&logFormat, "log-format", "text", "Log output format [options: text, json]")
+	onboardCmd.Flags().StringVar(&otlpEndpoint, "otlp-endpoint", "", "OTLP/gRPC endpoint to export TO1/TO2 tracing spans to (tracing disabled if empty)")
+}
+
+// tracer is used to start spans around each onboarding protocol phase.
+var tracer = otel.Tracer("github.com/fido-device-onboard/go-fdo-client")
+
+// initLogging configures the default slog logger's output format. It must
+// run after flag parsing and before the first log line is emitted.
+func initLogging() {
+	handlerOpts := &slog.HandlerOptions{Level: level}
+
+	var handler slog.Handler
+	switch logFormat {
+	case "json":
+		handler = slog.NewJSONHandler(os.Stderr, handlerOpts)
+	default:
+		handler = slog.NewTextHandler(os.Stderr, handlerOpts)
+	}
+
+	slog.SetDefault(slog.New(handler))
+}
+
+// initTracing wires up an OTLP/gRPC span exporter when --otlp-endpoint is
+// set, so that owner servers running OTel can stitch this device's TO1/TO2
+// spans into an end-to-end onboarding trace. The returned shutdown func
+// must be called (and its error checked) before the process exits, to
+// flush any spans still buffered.
+func initTracing(ctx context.Context) (shutdown func(context.Context) error, err error) {
+	// Set the W3C traceparent propagator, and wrap the process-wide default
+	// HTTP transport with otelhttp so that any HTTP client dialed without
+	// its own RoundTripper (the Go stdlib default that internal/tls's
+	// dialer uses) injects the active TO1/TO2 span into its outgoing
+	// request headers. Both apply regardless of whether export is enabled,
+	// since deviceTransport is built before we know if a given attempt's
+	// span will be sampled.
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	http.DefaultTransport = otelhttp.NewTransport(http.DefaultTransport)
+
+	if otlpEndpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithEndpoint(otlpEndpoint), otlptracegrpc.WithInsecure())
+	if err != nil {
+		return nil, fmt.Errorf("create OTLP trace exporter: %w", err)
+	}
+
+	res := resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName("go-fdo-client"))
+	tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}